@@ -1,13 +1,16 @@
 package provider
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tpretz/go-zabbix-api"
 )
 
@@ -21,11 +24,12 @@ var lldCommonSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
 	},
 	"delay": &schema.Schema{
-		Type:         schema.TypeString,
-		Optional:     true,
-		ValidateFunc: validation.StringIsNotWhiteSpace,
-		Default:      "3600",
-		Description:  "LLD Delay period",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validateZabbixInterval,
+		DiffSuppressFunc: suppressZabbixIntervalDiff,
+		Default:          "3600",
+		Description:      "LLD Delay period",
 	},
 	"key": &schema.Schema{
 		Type:         schema.TypeString,
@@ -39,6 +43,9 @@ var lldCommonSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringIsNotWhiteSpace,
 		Required:     true,
 	},
+	"filter":         lldFilterSchema(),
+	"lld_macro_path": lldMacroPathSchema,
+	"override":       lldOverrideSchema,
 }
 
 // Interface schema
@@ -51,6 +58,321 @@ var lldInterfaceSchema = map[string]*schema.Schema{
 	},
 }
 
+// Schema for a single filter condition
+var lldFilterConditionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"macro": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "LLD macro to test, e.g. {#FSTYPE}",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "8",
+				Description:  "Condition operator, zabbix identifier number",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value to test the macro against",
+			},
+			"formulaid": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Arbitrary unique ID used to reference this condition from a custom formula",
+			},
+		},
+	},
+}
+
+// Schema for the lld filter block, shared between the rule itself and overrides
+func lldFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"eval_type": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "0",
+					Description:  "Filter condition evaluation method, zabbix identifier number",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
+				},
+				"formula": &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Custom condition evaluation formula, required when eval_type is custom expression",
+				},
+				"condition": lldFilterConditionSchema,
+			},
+		},
+	}
+}
+
+// Schema for lld_macro_paths entries
+var lldMacroPathSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"lld_macro": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "LLD macro, e.g. {#FSTYPE}",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "JSONPath to populate the macro value with",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	},
+}
+
+// Schema for override operation blocks
+var lldOverrideOperationSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"operationobject": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Type of the discovered object the operation applies to, zabbix identifier number",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
+			},
+			"operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "8",
+				Description:  "Condition operator used to match the object for this operation, zabbix identifier number",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value to match the object against",
+			},
+			"opstatus": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Status to assign to the discovered object",
+			},
+			"opdiscover": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Discovery status to assign to the discovered object",
+			},
+			"opperiod": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Update interval to assign to a discovered item",
+			},
+		},
+	},
+}
+
+// Schema for overrides entries
+var lldOverrideSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Override name",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"step": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Override evaluation step",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9]+$"), "must be numeric"),
+			},
+			"stop": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "0",
+				Description: "Whether to stop processing overrides once this one matches",
+			},
+			"filter":    lldFilterSchema(),
+			"operation": lldOverrideOperationSchema,
+		},
+	},
+}
+
+var zabbixSimpleIntervalRegexp = regexp.MustCompile(`^[0-9]+[smhdw]?$`)
+var zabbixFlexibleIntervalRegexp = regexp.MustCompile(`^[0-9]+[smhdw]?/[1-7](-[1-7])?(,[1-7](-[1-7])?)*,[0-2][0-9]:[0-5][0-9]-[0-2][0-9]:[0-5][0-9]$`)
+var zabbixSchedulingIntervalRegexp = regexp.MustCompile(`^wd[1-7](-[1-7])?h[0-9]{1,2}(-[0-9]{1,2})?(m[0-9]{1,2}(-[0-9]{1,2})?)?$`)
+
+// validateZabbixInterval validates a Zabbix update interval: a simple
+// interval, optionally unit-suffixed (e.g. "30", "30s", "5m", "1h"),
+// optionally followed by ';'-separated custom intervals such as
+// "50s/1-5,09:00-18:00" (flexible) or "wd1-5h9-18" (scheduling).
+func validateZabbixInterval(i interface{}, k string) (warnings []string, errs []error) {
+	v, ok := i.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must be a string", k))
+		return
+	}
+
+	parts := strings.Split(v, ";")
+	if !zabbixSimpleIntervalRegexp.MatchString(parts[0]) {
+		errs = append(errs, fmt.Errorf("%q: %q is not a valid Zabbix update interval, e.g. \"30s\", \"5m\", \"1h\"", k, parts[0]))
+		return
+	}
+
+	for _, custom := range parts[1:] {
+		if !zabbixFlexibleIntervalRegexp.MatchString(custom) && !zabbixSchedulingIntervalRegexp.MatchString(custom) {
+			errs = append(errs, fmt.Errorf("%q: custom interval %q is not a valid flexible or scheduling interval", k, custom))
+		}
+	}
+
+	return
+}
+
+// zabbixIntervalUnits maps a simple interval's unit suffix to its multiplier
+// in seconds.
+var zabbixIntervalUnits = map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+// zabbixIntervalToSeconds converts a plain simple interval to seconds, or
+// returns -1 if it isn't one (custom intervals never compare as equal).
+func zabbixIntervalToSeconds(v string) int {
+	if v == "" || strings.ContainsAny(v, ";/") {
+		return -1
+	}
+
+	if mult, ok := zabbixIntervalUnits[v[len(v)-1]]; ok {
+		n, err := strconv.Atoi(v[:len(v)-1])
+		if err != nil {
+			return -1
+		}
+		return n * mult
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// suppressZabbixIntervalDiff treats equivalent simple intervals, e.g.
+// "3600" and "1h", as unchanged.
+func suppressZabbixIntervalDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := zabbixIntervalToSeconds(old), zabbixIntervalToSeconds(new)
+	return o != -1 && n != -1 && o == n
+}
+
+// lldPreprocessorParamCounts documents the param cardinality Zabbix expects
+// per numeric preprocessor type, for the types this provider version
+// recognizes. Types absent from this map are passed through unvalidated for
+// forward-compatibility.
+var lldPreprocessorParamCounts = map[string]int{
+	"1":  1, // custom multiplier
+	"2":  1, // right trim
+	"3":  1, // left trim
+	"4":  1, // trim
+	"5":  2, // regular expression
+	"11": 1, // XPath
+	"12": 1, // JSONPath
+	"13": 1, // in range
+	"14": 1, // matches regular expression
+	"15": 1, // does not match regular expression
+	"16": 0, // check for error in JSON
+	"17": 0, // check for error in XML
+	"20": 3, // prometheus pattern
+	"21": 1, // prometheus to JSON
+	"22": 0, // CSV to JSON
+}
+
+// lldValidErrorHandlers are the documented values for a preprocessor's
+// error_handler: empty (default), or the Zabbix identifiers for discard (0),
+// set value (1), set error (2), and custom error (3).
+var lldValidErrorHandlers = map[string]bool{"": true, "0": true, "1": true, "2": true, "3": true}
+
+// lldPreprocessorCustomizeDiff rejects preprocessor blocks whose params
+// cardinality doesn't match their (recognized) type, and error_handler
+// values outside the documented enum.
+func lldPreprocessorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	count := d.Get("preprocessor.#").(int)
+
+	for i := 0; i < count; i++ {
+		prefix := fmt.Sprintf("preprocessor.%d.", i)
+		ppType := d.Get(prefix + "type").(string)
+
+		rawParams := d.Get(prefix + "params").([]interface{})
+		params := make([]string, len(rawParams))
+		for j, p := range rawParams {
+			params[j] = p.(string)
+		}
+
+		if err := lldValidatePreprocessorParams(params); err != nil {
+			return err
+		}
+
+		if expected, known := lldPreprocessorParamCounts[ppType]; known && len(params) != expected {
+			return fmt.Errorf("preprocessor type %q requires %d param(s), got %d", ppType, expected, len(params))
+		}
+
+		errorHandler := d.Get(prefix + "error_handler").(string)
+		if !lldValidErrorHandlers[errorHandler] {
+			return fmt.Errorf("preprocessor error_handler %q must be one of \"\", \"0\", \"1\", \"2\", \"3\"", errorHandler)
+		}
+	}
+
+	return nil
+}
+
+// lldValidatePreprocessorParams rejects a param containing a literal
+// newline. Zabbix wire-encodes a preprocessor's params as a single
+// "\n"-joined string, so an embedded newline in one param is
+// indistinguishable from the inter-param delimiter and would silently
+// corrupt the round trip.
+func lldValidatePreprocessorParams(params []string) error {
+	for _, p := range params {
+		if strings.Contains(p, "\n") {
+			return fmt.Errorf("preprocessor param %q must not contain a newline: params are joined with \"\\n\" on the wire, so an embedded newline can't be told apart from the delimiter", p)
+		}
+	}
+	return nil
+}
+
+// lldCustomizeDiff is wired into zabbix_lld_* resource constructors as
+// CustomizeDiff, alongside lldImporter.
+var lldCustomizeDiff = lldPreprocessorCustomizeDiff
+
+// lldPreprocessorWarnings returns forward-compatibility warnings for
+// preprocessor types this provider version doesn't recognize, rather than
+// hard-failing them in CustomizeDiff.
+func lldPreprocessorWarnings(preprocessors zabbix.Preprocessors) (diags diag.Diagnostics) {
+	for _, p := range preprocessors {
+		if _, known := lldPreprocessorParamCounts[p.Type]; !known {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unrecognized preprocessor type",
+				Detail:   fmt.Sprintf("preprocessor type %q is not recognized by this provider version; its params are passed through to Zabbix without cardinality validation", p.Type),
+			})
+		}
+	}
+	return
+}
+
 // Schema for preprocessor blocks
 var lldPreprocessorSchema = &schema.Schema{
 	Type:     schema.TypeList,
@@ -90,92 +412,104 @@ var lldPreprocessorSchema = &schema.Schema{
 	},
 }
 
+// Shared importer for zabbix_lld_* resources, importing by the numeric itemid
+// of an existing discovery rule. resourceLLDRead is sufficient to populate
+// the full resource state on the following refresh.
+var lldImporter = &schema.ResourceImporter{
+	StateContext: schema.ImportStatePassthroughContext,
+}
+
 // Function signature for context manipulation
-type LLDHandler func(*schema.ResourceData, *zabbix.LLDRule)
+type LLDHandler func(context.Context, *schema.ResourceData, *zabbix.LLDRule)
 
-// return a terraform CreateFunc
-func lldGetCreateWrapper(c LLDHandler, r LLDHandler) schema.CreateFunc {
-	return func(d *schema.ResourceData, m interface{}) error {
-		return resourceLLDCreate(d, m, c, r)
+// return a terraform CreateContextFunc
+func lldGetCreateWrapper(c LLDHandler, r LLDHandler) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return resourceLLDCreate(ctx, d, m, c, r)
 	}
 }
 
-// return a terraform UpdateFunc
-func lldGetUpdateWrapper(c LLDHandler, r LLDHandler) schema.UpdateFunc {
-	return func(d *schema.ResourceData, m interface{}) error {
-		return resourceLLDUpdate(d, m, c, r)
+// return a terraform UpdateContextFunc
+func lldGetUpdateWrapper(c LLDHandler, r LLDHandler) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return resourceLLDUpdate(ctx, d, m, c, r)
 	}
 }
 
-// return a terraform ReadFunc
-func lldGetReadWrapper(r LLDHandler) schema.ReadFunc {
-	return func(d *schema.ResourceData, m interface{}) error {
-		return resourceLLDRead(d, m, r)
+// return a terraform ReadContextFunc
+func lldGetReadWrapper(r LLDHandler) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return resourceLLDRead(ctx, d, m, r)
 	}
 }
 
 // Create lld Resource Handler
-func resourceLLDCreate(d *schema.ResourceData, m interface{}, c LLDHandler, r LLDHandler) error {
+func resourceLLDCreate(ctx context.Context, d *schema.ResourceData, m interface{}, c LLDHandler, r LLDHandler) diag.Diagnostics {
 	api := m.(*zabbix.API)
 
 	lld := buildLLDObject(d)
 
 	// run custom function
-	c(d, lld)
+	c(ctx, d, lld)
 
-	log.Trace("preparing lld object for create/update: %#v", lld)
+	tflog.Trace(ctx, "preparing lld object for create", map[string]interface{}{"lld": lld})
 
 	llds := []zabbix.LLDRule{*lld}
 
 	err := api.LLDsCreate(llds)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	log.Trace("created lld: %+v", llds[0])
+	tflog.Trace(ctx, "created lld", map[string]interface{}{"lld": llds[0]})
 
 	d.SetId(llds[0].ItemID)
 
-	return resourceLLDRead(d, m, r)
+	diags := lldPreprocessorWarnings(lld.Preprocessors)
+	return append(diags, resourceLLDRead(ctx, d, m, r)...)
 }
 
 // Update lld Resource Handler
-func resourceLLDUpdate(d *schema.ResourceData, m interface{}, c LLDHandler, r LLDHandler) error {
+func resourceLLDUpdate(ctx context.Context, d *schema.ResourceData, m interface{}, c LLDHandler, r LLDHandler) diag.Diagnostics {
 	api := m.(*zabbix.API)
 
 	lld := buildLLDObject(d)
 	lld.ItemID = d.Id()
 
 	// run custom function
-	c(d, lld)
+	c(ctx, d, lld)
 
-	log.Trace("preparing lld object for create/update: %#v", lld)
+	tflog.Trace(ctx, "preparing lld object for update", map[string]interface{}{"lld": lld})
 
 	llds := []zabbix.LLDRule{*lld}
 
 	err := api.LLDsUpdate(llds)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceLLDRead(d, m, r)
+	diags := lldPreprocessorWarnings(lld.Preprocessors)
+	return append(diags, resourceLLDRead(ctx, d, m, r)...)
 }
 
 // Read lld Resource Handler
-func resourceLLDRead(d *schema.ResourceData, m interface{}, r LLDHandler) error {
+func resourceLLDRead(ctx context.Context, d *schema.ResourceData, m interface{}, r LLDHandler) diag.Diagnostics {
 	api := m.(*zabbix.API)
 
-	log.Debug("Lookup of lld with id %s", d.Id())
+	tflog.Debug(ctx, "Lookup of lld", map[string]interface{}{"itemid": d.Id()})
 
 	llds, err := api.LLDsGet(zabbix.Params{
 		"lldids":              []string{d.Id()},
 		"selectPreprocessing": "extend",
+		"selectFilter":        "extend",
+		"selectLLDMacroPaths": "extend",
+		"selectOverrides":     "extend",
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(llds) < 1 {
@@ -183,11 +517,11 @@ func resourceLLDRead(d *schema.ResourceData, m interface{}, r LLDHandler) error
 		return nil
 	}
 	if len(llds) > 1 {
-		return errors.New("multiple llds found")
+		return diag.Errorf("multiple llds found")
 	}
 	lld := llds[0]
 
-	log.Debug("Got lld: %+v", lld)
+	tflog.Debug(ctx, "Got lld", map[string]interface{}{"lld": lld})
 
 	d.SetId(lld.ItemID)
 	d.Set("hostid", lld.HostID)
@@ -195,9 +529,16 @@ func resourceLLDRead(d *schema.ResourceData, m interface{}, r LLDHandler) error
 	d.Set("name", lld.Name)
 	d.Set("delay", lld.Delay)
 	d.Set("preprocessor", flattenlldPreprocessors(lld))
+	if lldFilterIsEmpty(lld.Filter) {
+		d.Set("filter", []interface{}{})
+	} else {
+		d.Set("filter", flattenLLDFilter(lld.Filter))
+	}
+	d.Set("lld_macro_path", flattenLLDMacroPaths(lld.LLDMacroPaths))
+	d.Set("override", flattenLLDOverrides(lld.Overrides))
 
 	// run custom
-	r(d, &lld)
+	r(ctx, d, &lld)
 
 	return nil
 }
@@ -211,6 +552,9 @@ func buildLLDObject(d *schema.ResourceData) *zabbix.LLDRule {
 		Delay:  d.Get("delay").(string),
 	}
 	lld.Preprocessors = lldGeneratePreprocessors(d)
+	lld.Filter = lldGenerateFilter(d, "filter.0.")
+	lld.LLDMacroPaths = lldGenerateMacroPaths(d)
+	lld.Overrides = lldGenerateOverrides(d)
 
 	return &lld
 }
@@ -255,8 +599,159 @@ func flattenlldPreprocessors(lld zabbix.LLDRule) []interface{} {
 	return val
 }
 
+// Generate a filter object from a "filter" block at the given schema prefix
+func lldGenerateFilter(d *schema.ResourceData, prefix string) zabbix.LLDFilter {
+	conditionCount := d.Get(prefix + "condition.#").(int)
+	conditions := make([]zabbix.LLDFilterCondition, conditionCount)
+
+	for i := 0; i < conditionCount; i++ {
+		cprefix := fmt.Sprintf("%scondition.%d.", prefix, i)
+		conditions[i] = zabbix.LLDFilterCondition{
+			Macro:     d.Get(cprefix + "macro").(string),
+			Operator:  d.Get(cprefix + "operator").(string),
+			Value:     d.Get(cprefix + "value").(string),
+			FormulaID: d.Get(cprefix + "formulaid").(string),
+		}
+	}
+
+	return zabbix.LLDFilter{
+		EvalType:   d.Get(prefix + "eval_type").(string),
+		Formula:    d.Get(prefix + "formula").(string),
+		Conditions: conditions,
+	}
+}
+
+// lldFilterIsEmpty reports whether a filter represents "no filter
+// configured" (Zabbix's default eval_type with no conditions), so a rule
+// with no "filter" block in config doesn't show a perpetual plan diff
+// against the default filter object the API always returns.
+func lldFilterIsEmpty(filter zabbix.LLDFilter) bool {
+	return filter.EvalType == "0" && len(filter.Conditions) == 0
+}
+
+// Generate terraform flattened form of an lld filter
+func flattenLLDFilter(filter zabbix.LLDFilter) []interface{} {
+	conditions := make([]interface{}, len(filter.Conditions))
+	for i, condition := range filter.Conditions {
+		conditions[i] = map[string]interface{}{
+			"macro":     condition.Macro,
+			"operator":  condition.Operator,
+			"value":     condition.Value,
+			"formulaid": condition.FormulaID,
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"eval_type": filter.EvalType,
+			"formula":   filter.Formula,
+			"condition": conditions,
+		},
+	}
+}
+
+// Generate lld_macro_path objects from the "lld_macro_path" blocks
+func lldGenerateMacroPaths(d *schema.ResourceData) zabbix.LLDMacroPaths {
+	count := d.Get("lld_macro_path.#").(int)
+	paths := make(zabbix.LLDMacroPaths, count)
+
+	for i := 0; i < count; i++ {
+		prefix := fmt.Sprintf("lld_macro_path.%d.", i)
+		paths[i] = zabbix.LLDMacroPath{
+			LLDMacro: d.Get(prefix + "lld_macro").(string),
+			Path:     d.Get(prefix + "path").(string),
+		}
+	}
+
+	return paths
+}
+
+// Generate terraform flattened form of lld macro paths
+func flattenLLDMacroPaths(paths zabbix.LLDMacroPaths) []interface{} {
+	val := make([]interface{}, len(paths))
+	for i, path := range paths {
+		val[i] = map[string]interface{}{
+			"lld_macro": path.LLDMacro,
+			"path":      path.Path,
+		}
+	}
+	return val
+}
+
+// Generate override objects from the "override" blocks
+func lldGenerateOverrides(d *schema.ResourceData) zabbix.LLDOverrides {
+	count := d.Get("override.#").(int)
+	overrides := make(zabbix.LLDOverrides, count)
+
+	for i := 0; i < count; i++ {
+		prefix := fmt.Sprintf("override.%d.", i)
+
+		opCount := d.Get(prefix + "operation.#").(int)
+		operations := make(zabbix.LLDOverrideOperations, opCount)
+		for j := 0; j < opCount; j++ {
+			oprefix := fmt.Sprintf("%soperation.%d.", prefix, j)
+			operations[j] = zabbix.LLDOverrideOperation{
+				OperationObject: d.Get(oprefix + "operationobject").(string),
+				Operator:        d.Get(oprefix + "operator").(string),
+				Value:           d.Get(oprefix + "value").(string),
+				OpStatus:        d.Get(oprefix + "opstatus").(string),
+				OpDiscover:      d.Get(oprefix + "opdiscover").(string),
+				OpPeriod:        d.Get(oprefix + "opperiod").(string),
+			}
+		}
+
+		overrides[i] = zabbix.LLDOverride{
+			Name:       d.Get(prefix + "name").(string),
+			Step:       d.Get(prefix + "step").(string),
+			Stop:       d.Get(prefix + "stop").(string),
+			Filter:     lldGenerateFilter(d, prefix+"filter.0."),
+			Operations: operations,
+		}
+	}
+
+	return overrides
+}
+
+// Generate terraform flattened form of lld overrides
+func flattenLLDOverrides(overrides zabbix.LLDOverrides) []interface{} {
+	val := make([]interface{}, len(overrides))
+	for i, override := range overrides {
+		operations := make([]interface{}, len(override.Operations))
+		for j, op := range override.Operations {
+			operations[j] = map[string]interface{}{
+				"operationobject": op.OperationObject,
+				"operator":        op.Operator,
+				"value":           op.Value,
+				"opstatus":        op.OpStatus,
+				"opdiscover":      op.OpDiscover,
+				"opperiod":        op.OpPeriod,
+			}
+		}
+
+		filter := []interface{}{}
+		if !lldFilterIsEmpty(override.Filter) {
+			filter = flattenLLDFilter(override.Filter)
+		}
+
+		val[i] = map[string]interface{}{
+			"name":      override.Name,
+			"step":      override.Step,
+			"stop":      override.Stop,
+			"filter":    filter,
+			"operation": operations,
+		}
+	}
+	return val
+}
+
 // Delete lld Resource Handler
-func resourceLLDDelete(d *schema.ResourceData, m interface{}) error {
+func resourceLLDDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*zabbix.API)
-	return api.LLDDeleteByIds([]string{d.Id()})
+
+	err := api.LLDDeleteByIds([]string{d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
\ No newline at end of file
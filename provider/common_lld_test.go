@@ -0,0 +1,37 @@
+package provider
+
+import "testing"
+
+func TestValidateZabbixInterval(t *testing.T) {
+	valid := []string{"30", "30s", "5m", "1h", "1d", "1w", "0;50s/1-5,09:00-18:00", "1h;wd1-5h9-18"}
+	for _, v := range valid {
+		if _, errs := validateZabbixInterval(v, "delay"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got errors: %v", v, errs)
+		}
+	}
+
+	invalid := []string{"", "abc", "30x", "1h;bogus"}
+	for _, v := range invalid {
+		if _, errs := validateZabbixInterval(v, "delay"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestSuppressZabbixIntervalDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"3600", "1h", true},
+		{"60", "1m", true},
+		{"3600", "2h", false},
+		{"3600", "50s/1-5,09:00-18:00", false},
+	}
+
+	for _, c := range cases {
+		if got := suppressZabbixIntervalDiff("delay", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("suppressZabbixIntervalDiff(%q, %q) = %v, want %v", c.old, c.new, got, c.suppress)
+		}
+	}
+}
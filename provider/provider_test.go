@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testAccProvider *schema.Provider
+var testAccProviders map[string]*schema.Provider
+
+func init() {
+	testAccProvider = Provider()
+	testAccProviders = map[string]*schema.Provider{
+		"zabbix": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// testAccPreCheck verifies the environment variables required to reach a
+// live Zabbix instance are set before running acceptance tests.
+func testAccPreCheck(t *testing.T) {
+	for _, name := range []string{"ZABBIX_URL", "ZABBIX_USER", "ZABBIX_PASSWORD"} {
+		if os.Getenv(name) == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
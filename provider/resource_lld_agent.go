@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/tpretz/go-zabbix-api"
+)
+
+// resourceLLDAgent returns the zabbix_lld_agent resource, a low level
+// discovery rule polled directly by a Zabbix agent. It has no type-specific
+// fields beyond the common lld schema and the preprocessor block, so its
+// handlers are no-ops.
+func resourceLLDAgent() *schema.Resource {
+	lldSchema := map[string]*schema.Schema{
+		"preprocessor": lldPreprocessorSchema,
+	}
+	for k, v := range lldCommonSchema {
+		lldSchema[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: lldGetCreateWrapper(noopLLDHandler, noopLLDHandler),
+		ReadContext:   lldGetReadWrapper(noopLLDHandler),
+		UpdateContext: lldGetUpdateWrapper(noopLLDHandler, noopLLDHandler),
+		DeleteContext: resourceLLDDelete,
+
+		Importer: lldImporter,
+
+		CustomizeDiff: lldCustomizeDiff,
+
+		Schema: lldSchema,
+	}
+}
+
+// noopLLDHandler is used by lld resource types with no type-specific fields
+// to customize on the zabbix.LLDRule.
+func noopLLDHandler(ctx context.Context, d *schema.ResourceData, lld *zabbix.LLDRule) {}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/tpretz/go-zabbix-api"
+)
+
+const resourceNameLLDAgent = "zabbix_lld_agent.lld"
+
+// testAccLLDScratchHost renders a throwaway host to hang an LLD rule off of,
+// so tests don't interfere with each other's discovery rules.
+func testAccLLDScratchHost(host string) string {
+	return fmt.Sprintf(`
+resource "zabbix_host" "host" {
+  host   = %[1]q
+  groups = ["Linux servers"]
+
+  interface {
+    ip   = "127.0.0.1"
+    main = true
+  }
+}
+`, host)
+}
+
+func testAccLLDAgentConfigBasic(host, key, name, delay string) string {
+	return testAccLLDScratchHost(host) + fmt.Sprintf(`
+resource "zabbix_lld_agent" "lld" {
+  hostid = zabbix_host.host.id
+  key    = %[1]q
+  name   = %[2]q
+  delay  = %[3]q
+}
+`, key, name, delay)
+}
+
+func testAccLLDAgentConfigPreprocessors(host, key, name string, preprocessors string) string {
+	return testAccLLDScratchHost(host) + fmt.Sprintf(`
+resource "zabbix_lld_agent" "lld" {
+  hostid = zabbix_host.host.id
+  key    = %[1]q
+  name   = %[2]q
+
+  %[3]s
+}
+`, key, name, preprocessors)
+}
+
+func TestAccLLDAgent_importBasic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLLDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLLDAgentConfigBasic("tf-acc-lld-agent-import", "lld.agent.key", "LLD Agent Test", "3600"),
+			},
+			{
+				ResourceName:      resourceNameLLDAgent,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLLDAgent_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLLDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLLDAgentConfigBasic("tf-acc-lld-agent-basic", "lld.agent.key", "LLD Agent Test", "3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "key", "lld.agent.key"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "name", "LLD Agent Test"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "delay", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLLDAgent_update(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLLDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLLDAgentConfigBasic("tf-acc-lld-agent-update", "lld.agent.key", "LLD Agent Test", "3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "delay", "3600"),
+				),
+			},
+			{
+				Config: testAccLLDAgentConfigBasic("tf-acc-lld-agent-update", "lld.agent.key", "LLD Agent Renamed", "90s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "name", "LLD Agent Renamed"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "delay", "90s"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLLDAgent_preprocessors(t *testing.T) {
+	onePreprocessor := `
+  preprocessor {
+    type   = "12"
+    params = ["$.filesystems[*]"]
+  }
+`
+	twoPreprocessorsReordered := `
+  preprocessor {
+    type                 = "5"
+    params               = ["(.*)", "\\1"]
+    error_handler         = "1"
+    error_handler_params  = "fallback value"
+  }
+
+  preprocessor {
+    type   = "12"
+    params = ["$.filesystems[*]"]
+  }
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLLDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLLDAgentConfigPreprocessors("tf-acc-lld-agent-preproc", "lld.agent.key", "LLD Agent Test", onePreprocessor),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.0.params.0", "$.filesystems[*]"),
+				),
+			},
+			{
+				// add, reorder, and round-trip a two-param regex preprocessor plus an error handler
+				Config: testAccLLDAgentConfigPreprocessors("tf-acc-lld-agent-preproc", "lld.agent.key", "LLD Agent Test", twoPreprocessorsReordered),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.0.params.1", "\\1"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.0.error_handler", "1"),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.0.error_handler_params", "fallback value"),
+				),
+			},
+			{
+				// remove back down to a single preprocessor
+				Config: testAccLLDAgentConfigPreprocessors("tf-acc-lld-agent-preproc", "lld.agent.key", "LLD Agent Test", onePreprocessor),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLLDExists(resourceNameLLDAgent),
+					resource.TestCheckResourceAttr(resourceNameLLDAgent, "preprocessor.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccLLDAgent_preprocessorMultilineParam regression-tests
+// lldGeneratePreprocessors/flattenlldPreprocessors joining and splitting
+// Preprocessor.Params on "\n": a param whose own value contains an embedded
+// newline (e.g. a multi-line regex or JSONPath) can't be told apart from the
+// inter-param delimiter on that wire encoding, so it must be rejected by
+// CustomizeDiff rather than silently corrupted.
+func TestAccLLDAgent_preprocessorMultilineParam(t *testing.T) {
+	multilineParam := `
+  preprocessor {
+    type   = "14"
+    params = ["^line1\nline2$"]
+  }
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLLDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLLDAgentConfigPreprocessors("tf-acc-lld-agent-preproc-ml", "lld.agent.key", "LLD Agent Test", multilineParam),
+				ExpectError: regexp.MustCompile("must not contain a newline"),
+			},
+		},
+	})
+}
+
+// testAccCheckLLDExists confirms the lld rule exists in zabbix via the API,
+// separately from whatever terraform has recorded in state.
+func testAccCheckLLDExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no lld rule ID set")
+		}
+
+		api := testAccProvider.Meta().(*zabbix.API)
+		llds, err := api.LLDsGet(zabbix.Params{
+			"lldids": []string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(llds) != 1 {
+			return fmt.Errorf("lld rule %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckLLDDestroy verifies every zabbix_lld_* resource in state was
+// actually removed from zabbix.
+func testAccCheckLLDDestroy(s *terraform.State) error {
+	api := testAccProvider.Meta().(*zabbix.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "zabbix_lld_agent" {
+			continue
+		}
+
+		llds, err := api.LLDsGet(zabbix.Params{
+			"lldids": []string{rs.Primary.ID},
+		})
+		if err != nil {
+			return err
+		}
+		if len(llds) > 0 {
+			return fmt.Errorf("lld rule %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}